@@ -0,0 +1,111 @@
+package twosum
+
+import (
+	"context"
+	"sync"
+)
+
+// TwoSumParallel shards nums into workers contiguous partitions and scans
+// them concurrently. Each worker first resolves matches local to its own
+// partition with a private complement map, then probes a shared map for
+// matches against partitions published by other workers and publishes its
+// own values to it (keeping the smallest index per value). Once any
+// goroutine finds a pair, the shared context is cancelled, and workers
+// whose partitions start after that pair's max index stop early. Among all
+// pairs found, the one with the smallest max index (then the smallest min
+// index) is returned so the result is deterministic regardless of
+// goroutine scheduling, with i < j as in TwoSum. It returns nil if no pair
+// sums to target.
+func TwoSumParallel(nums []int, target int, workers int) []int {
+	if workers < 1 {
+		workers = 1
+	}
+	n := len(nums)
+	if n == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mu     sync.Mutex
+		shared = make(map[int]int, n)
+		best   []int
+	)
+
+	// consider keeps (i, j) if it beats best: a smaller max index wins, and
+	// on a tie the smaller index sum (hence the smaller min index) wins.
+	// mu must be held.
+	consider := func(i, j int) {
+		m := maxInt(i, j)
+		if best == nil || m < maxInt(best[0], best[1]) ||
+			(m == maxInt(best[0], best[1]) && i+j < best[0]+best[1]) {
+			best = []int{i, j}
+		}
+		cancel()
+	}
+
+	chunk := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+
+			local := make(map[int]int, end-start)
+			for idx := start; idx < end; idx++ {
+				select {
+				case <-ctx.Done():
+					// Every pair involving this partition has a max index
+					// of at least start, so stop only if best ends before it.
+					mu.Lock()
+					beaten := maxInt(best[0], best[1]) < start
+					mu.Unlock()
+					if beaten {
+						return
+					}
+				default:
+				}
+				num := nums[idx]
+				if c, ok := local[target-num]; ok {
+					mu.Lock()
+					consider(c, idx)
+					mu.Unlock()
+					break
+				}
+				local[num] = idx
+			}
+
+			mu.Lock()
+			for idx := start; idx < end; idx++ {
+				if c, ok := shared[target-nums[idx]]; ok {
+					consider(c, idx)
+				}
+			}
+			for idx := start; idx < end; idx++ {
+				if old, ok := shared[nums[idx]]; !ok || idx < old {
+					shared[nums[idx]] = idx
+				}
+			}
+			mu.Unlock()
+		}(start, end)
+	}
+	wg.Wait()
+
+	if best != nil && best[0] > best[1] {
+		best[0], best[1] = best[1], best[0]
+	}
+	return best
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,37 @@
+package twosum
+
+import "testing"
+
+// benchInput builds a large slice with no matching pair, so every
+// benchmark scans the full input rather than short-circuiting early.
+func benchInput(n int) []int {
+	nums := make([]int, n)
+	for i := range nums {
+		nums[i] = i
+	}
+	return nums
+}
+
+func BenchmarkTwoSum_Sequential_10M(b *testing.B) {
+	nums := benchInput(10_000_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		twoSum(nums, -1)
+	}
+}
+
+func BenchmarkTwoSumParallel_4Workers_10M(b *testing.B) {
+	nums := benchInput(10_000_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		TwoSumParallel(nums, -1, 4)
+	}
+}
+
+func BenchmarkTwoSumParallel_8Workers_10M(b *testing.B) {
+	nums := benchInput(10_000_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		TwoSumParallel(nums, -1, 8)
+	}
+}
@@ -0,0 +1,60 @@
+package twosum
+
+import "testing"
+
+func TestTwoSumParallelFindsPair(t *testing.T) {
+	nums := []int{2, 7, 11, 15}
+	got := TwoSumParallel(nums, 9, 4)
+	if got == nil || got[0] != 0 || got[1] != 1 {
+		t.Fatalf("TwoSumParallel() = %v, want [0 1]", got)
+	}
+}
+
+func TestTwoSumParallelNoPair(t *testing.T) {
+	nums := []int{1, 2, 3}
+	if got := TwoSumParallel(nums, 100, 4); got != nil {
+		t.Errorf("TwoSumParallel() = %v, want nil", got)
+	}
+}
+
+func TestTwoSumParallelSmallestMaxIndex(t *testing.T) {
+	// (0, 4) and (2, 3) both sum to target; (2, 3) has the smaller max index.
+	nums := []int{1, 9, 2, 7, 8}
+	got := TwoSumParallel(nums, 9, 2)
+	if got == nil || got[0] != 2 || got[1] != 3 {
+		t.Fatalf("TwoSumParallel() = %v, want [2 3] (smallest max index)", got)
+	}
+}
+
+// TestTwoSumParallelIndexOrderIsStable guards against the ordering bug where
+// the cross-partition merge could return (j, i) instead of (i, j) depending
+// on which goroutine populated the shared map first.
+func TestTwoSumParallelIndexOrderIsStable(t *testing.T) {
+	const n = 10000
+	const base = 1_000_000 // spaced far enough apart that only the designed pair can sum to target
+	const target = 123
+
+	nums := make([]int, n)
+	for i := 1; i < n-1; i++ {
+		nums[i] = i * base
+	}
+	nums[0], nums[n-1] = 1, target-1 // the only pair summing to target
+
+	for run := 0; run < 200; run++ {
+		got := TwoSumParallel(nums, target, 8)
+		if got == nil {
+			t.Fatalf("run %d: TwoSumParallel() = nil, want a pair", run)
+		}
+		if got[0] != 0 || got[1] != n-1 {
+			t.Fatalf("run %d: TwoSumParallel() = %v, want [0 %d] (i < j)", run, got, n-1)
+		}
+	}
+}
+
+func TestTwoSumParallelSingleWorker(t *testing.T) {
+	nums := []int{3, 2, 4}
+	got := TwoSumParallel(nums, 6, 1)
+	if got == nil || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("TwoSumParallel() = %v, want [1 2]", got)
+	}
+}
@@ -0,0 +1,40 @@
+package twosum
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// TwoSumStream reads whitespace-separated integers from r and returns the
+// 0-based indices of the first pair it finds summing to target, without
+// buffering the whole input in memory. It uses the same complement-map
+// technique as twoSum, but one token at a time via bufio.Scanner, so it
+// can run over arbitrarily large or piped input. If no pair sums to
+// target, it returns io.EOF once r is exhausted.
+func TwoSumStream(r io.Reader, target int) (i, j int, err error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+
+	seen := make(map[int]int)
+	idx := 0
+	for scanner.Scan() {
+		token := scanner.Text()
+		num, convErr := strconv.Atoi(token)
+		if convErr != nil {
+			return 0, 0, fmt.Errorf("twosum: invalid integer %q: %w", token, convErr)
+		}
+
+		complement := target - num
+		if c, ok := seen[complement]; ok {
+			return c, idx, nil
+		}
+		seen[num] = idx
+		idx++
+	}
+	if scanErr := scanner.Err(); scanErr != nil {
+		return 0, 0, scanErr
+	}
+	return 0, 0, io.EOF
+}
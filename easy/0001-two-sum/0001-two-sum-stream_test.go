@@ -0,0 +1,45 @@
+package twosum
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestTwoSumStreamFindsPair(t *testing.T) {
+	i, j, err := TwoSumStream(strings.NewReader("2 7 11 15"), 9)
+	if err != nil {
+		t.Fatalf("TwoSumStream() error = %v, want nil", err)
+	}
+	if i != 0 || j != 1 {
+		t.Errorf("TwoSumStream() = (%d, %d), want (0, 1)", i, j)
+	}
+}
+
+func TestTwoSumStreamNoPairReturnsEOF(t *testing.T) {
+	_, _, err := TwoSumStream(strings.NewReader("1 2 3"), 100)
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("TwoSumStream() error = %v, want io.EOF", err)
+	}
+}
+
+func TestTwoSumStreamInvalidToken(t *testing.T) {
+	_, _, err := TwoSumStream(strings.NewReader("2 not-a-number 7"), 9)
+	if err == nil {
+		t.Fatal("TwoSumStream() error = nil, want non-nil for invalid token")
+	}
+	if errors.Is(err, io.EOF) {
+		t.Error("TwoSumStream() error = io.EOF, want a conversion error")
+	}
+}
+
+func TestTwoSumStreamWhitespaceSeparated(t *testing.T) {
+	i, j, err := TwoSumStream(strings.NewReader("\n3\t5  -4\n2\n"), 1)
+	if err != nil {
+		t.Fatalf("TwoSumStream() error = %v, want nil", err)
+	}
+	if i != 1 || j != 2 {
+		t.Errorf("TwoSumStream() = (%d, %d), want (1, 2)", i, j)
+	}
+}
@@ -1,13 +1,32 @@
+package twosum
+
+import "errors"
+
+// ErrNoPair is returned when no two numbers in nums sum to target.
+var ErrNoPair = errors.New("twosum: no two numbers sum to target")
+
+// TwoSum returns the indices of the two numbers in nums that add up to
+// target. A [2]int return encodes the "exactly two indices" invariant at
+// the type level, and ErrNoPair makes the no-solution case explicit
+// instead of an easily-ignored nil or empty slice.
+func TwoSum(nums []int, target int) ([2]int, error) {
+	m := make(map[int]int)
+	for idx, num := range nums {
+		complement := target - num
+		if c, ok := m[complement]; ok {
+			return [2]int{c, idx}, nil
+		}
+		m[num] = idx
+	}
+	return [2]int{}, ErrNoPair
+}
+
+// Deprecated: use TwoSum instead. twoSum returns nil when no pair is
+// found, which callers can easily mistake for a valid (if empty) result.
 func twoSum(nums []int, target int) []int {
-    m := make(map[int]int)
-    var ans []int
-    for idx, num := range nums {
-        complement := target - num
-        if c, ok := m[complement]; ok {
-            ans = []int{c, idx}
-            break
-        }
-        m[num] = idx
-    }
-    return ans     
-}
\ No newline at end of file
+	pair, err := TwoSum(nums, target)
+	if err != nil {
+		return nil
+	}
+	return []int{pair[0], pair[1]}
+}
@@ -0,0 +1,41 @@
+package twosum
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTwoSumFindsPair(t *testing.T) {
+	got, err := TwoSum([]int{2, 7, 11, 15}, 9)
+	if err != nil {
+		t.Fatalf("TwoSum() error = %v, want nil", err)
+	}
+	if got != [2]int{0, 1} {
+		t.Errorf("TwoSum() = %v, want [0 1]", got)
+	}
+}
+
+func TestTwoSumNoPairReturnsErrNoPair(t *testing.T) {
+	_, err := TwoSum([]int{1, 2, 3}, 100)
+	if !errors.Is(err, ErrNoPair) {
+		t.Errorf("TwoSum() error = %v, want ErrNoPair", err)
+	}
+}
+
+func TestTwoSumDeprecatedWrapperMatchesTwoSum(t *testing.T) {
+	nums := []int{3, 2, 4}
+	pair, err := TwoSum(nums, 6)
+	if err != nil {
+		t.Fatalf("TwoSum() error = %v, want nil", err)
+	}
+	got := twoSum(nums, 6)
+	if got == nil || got[0] != pair[0] || got[1] != pair[1] {
+		t.Errorf("twoSum() = %v, want %v", got, pair[:])
+	}
+}
+
+func TestTwoSumDeprecatedWrapperNoPairReturnsNil(t *testing.T) {
+	if got := twoSum([]int{1, 2, 3}, 100); got != nil {
+		t.Errorf("twoSum() = %v, want nil", got)
+	}
+}
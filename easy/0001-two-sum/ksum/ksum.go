@@ -0,0 +1,133 @@
+// Package ksum generalizes the classic two-sum problem to k numbers.
+package ksum
+
+import "sort"
+
+// Strategy selects the algorithm used to solve the base 2-sum case that the
+// k-sum recursion eventually reduces to.
+type Strategy int
+
+const (
+	// StrategySortedRecursive is the default: sort once, then recurse down
+	// to a 2-sum base case solved with the two-pointer technique.
+	StrategySortedRecursive Strategy = iota
+	// StrategyTwoPointer solves the base case with two pointers walking
+	// inward over the sorted slice. O(1) extra space for the base case.
+	StrategyTwoPointer
+	// StrategyHashMap solves the base case with a complement map, trading
+	// memory for fewer comparisons on the base case.
+	StrategyHashMap
+)
+
+type indexedValue struct {
+	val int
+	idx int
+}
+
+// KSum returns one tuple of k original indices into nums for each distinct
+// combination of values that sums to target; index tuples that repeat an
+// already returned combination of values are not returned. It uses the
+// default StrategySortedRecursive.
+func KSum(nums []int, target int, k int) [][]int {
+	return KSumWithStrategy(nums, target, k, StrategySortedRecursive)
+}
+
+// KSumWithStrategy is KSum with an explicit Strategy for the base 2-sum
+// case reached once the recursion bottoms out.
+func KSumWithStrategy(nums []int, target int, k int, strategy Strategy) [][]int {
+	if k < 2 || len(nums) < k {
+		return nil
+	}
+
+	sorted := make([]indexedValue, len(nums))
+	for i, n := range nums {
+		sorted[i] = indexedValue{val: n, idx: i}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].val < sorted[j].val })
+
+	return kSum(sorted, target, k, strategy)
+}
+
+// kSum fixes sorted[i] and reduces to (k-1)Sum on the suffix until it hits
+// the 2-sum base case, skipping duplicate values at each level so that only
+// unique tuples are produced.
+func kSum(sorted []indexedValue, target, k int, strategy Strategy) [][]int {
+	var ans [][]int
+
+	if k == 2 {
+		base := strategy
+		if base == StrategySortedRecursive {
+			base = StrategyTwoPointer
+		}
+		if base == StrategyHashMap {
+			return twoSumHashMap(sorted, target)
+		}
+		return twoSumTwoPointer(sorted, target)
+	}
+
+	n := len(sorted)
+	for i := 0; i <= n-k; i++ {
+		if i > 0 && sorted[i].val == sorted[i-1].val {
+			continue
+		}
+		for _, tuple := range kSum(sorted[i+1:], target-sorted[i].val, k-1, strategy) {
+			ans = append(ans, append([]int{sorted[i].idx}, tuple...))
+		}
+	}
+	return ans
+}
+
+// twoSumTwoPointer solves the base case by walking two pointers inward
+// over the sorted slice, skipping duplicate values on both ends.
+func twoSumTwoPointer(sorted []indexedValue, target int) [][]int {
+	var ans [][]int
+	lo, hi := 0, len(sorted)-1
+	for lo < hi {
+		sum := sorted[lo].val + sorted[hi].val
+		switch {
+		case sum == target:
+			ans = append(ans, []int{sorted[lo].idx, sorted[hi].idx})
+			lo++
+			hi--
+			for lo < hi && sorted[lo].val == sorted[lo-1].val {
+				lo++
+			}
+			for lo < hi && sorted[hi].val == sorted[hi+1].val {
+				hi--
+			}
+		case sum < target:
+			lo++
+		default:
+			hi--
+		}
+	}
+	return ans
+}
+
+// twoSumHashMap solves the base case with a complement map built up front,
+// trading O(n) memory for O(1) complement lookups instead of two pointers.
+func twoSumHashMap(sorted []indexedValue, target int) [][]int {
+	byVal := make(map[int][]int, len(sorted))
+	for _, v := range sorted {
+		byVal[v.val] = append(byVal[v.val], v.idx)
+	}
+
+	var ans [][]int
+	for i := 0; i < len(sorted); i++ {
+		if i > 0 && sorted[i].val == sorted[i-1].val {
+			continue
+		}
+		complement := target - sorted[i].val
+		indices, ok := byVal[complement]
+		if !ok {
+			continue
+		}
+		switch {
+		case complement > sorted[i].val:
+			ans = append(ans, []int{sorted[i].idx, indices[0]})
+		case complement == sorted[i].val && len(indices) > 1:
+			ans = append(ans, []int{indices[0], indices[1]})
+		}
+	}
+	return ans
+}
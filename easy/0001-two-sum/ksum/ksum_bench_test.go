@@ -0,0 +1,45 @@
+package ksum
+
+import "testing"
+
+// largeInput builds a deterministic slice of n distinct values so the
+// benchmarks are comparable across strategies and across runs.
+func largeInput(n int) []int {
+	nums := make([]int, n)
+	for i := range nums {
+		nums[i] = i*2 - n
+	}
+	return nums
+}
+
+func BenchmarkKSum_HashMap(b *testing.B) {
+	nums := largeInput(20000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KSumWithStrategy(nums, 3, 2, StrategyHashMap)
+	}
+}
+
+func BenchmarkKSum_TwoPointer(b *testing.B) {
+	nums := largeInput(20000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KSumWithStrategy(nums, 3, 2, StrategyTwoPointer)
+	}
+}
+
+func BenchmarkKSum_SortedRecursive3Sum(b *testing.B) {
+	nums := largeInput(2000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KSumWithStrategy(nums, 3, 3, StrategySortedRecursive)
+	}
+}
+
+func BenchmarkKSum_SortedRecursive4Sum(b *testing.B) {
+	nums := largeInput(400)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		KSumWithStrategy(nums, 3, 4, StrategySortedRecursive)
+	}
+}
@@ -0,0 +1,100 @@
+package ksum
+
+import (
+	"sort"
+	"testing"
+)
+
+// assertValidTuples checks that every tuple returned by KSum is k distinct,
+// in-range indices into nums whose values sum to target.
+func assertValidTuples(t *testing.T, nums []int, target, k int, tuples [][]int) {
+	t.Helper()
+	for _, tuple := range tuples {
+		if len(tuple) != k {
+			t.Fatalf("tuple %v has length %d, want %d", tuple, len(tuple), k)
+		}
+		seen := make(map[int]bool, k)
+		sum := 0
+		for _, idx := range tuple {
+			if idx < 0 || idx >= len(nums) {
+				t.Fatalf("tuple %v has out-of-range index %d", tuple, idx)
+			}
+			if seen[idx] {
+				t.Fatalf("tuple %v reuses index %d", tuple, idx)
+			}
+			seen[idx] = true
+			sum += nums[idx]
+		}
+		if sum != target {
+			t.Errorf("tuple %v sums to %d, want %d", tuple, sum, target)
+		}
+	}
+}
+
+func TestKSum2SumTwoPointer(t *testing.T) {
+	nums := []int{2, 7, 11, 15}
+	got := KSumWithStrategy(nums, 9, 2, StrategyTwoPointer)
+	assertValidTuples(t, nums, 9, 2, got)
+	if len(got) != 1 {
+		t.Fatalf("got %v, want exactly one pair", got)
+	}
+}
+
+func TestKSum2SumHashMap(t *testing.T) {
+	nums := []int{2, 7, 11, 15}
+	got := KSumWithStrategy(nums, 9, 2, StrategyHashMap)
+	assertValidTuples(t, nums, 9, 2, got)
+	if len(got) != 1 {
+		t.Fatalf("got %v, want exactly one pair", got)
+	}
+}
+
+func TestKSum2SumNoMatch(t *testing.T) {
+	nums := []int{1, 2, 3}
+	if got := KSum(nums, 100, 2); got != nil {
+		t.Errorf("KSum() = %v, want nil", got)
+	}
+}
+
+func TestKSum3SumSkipsDuplicates(t *testing.T) {
+	nums := []int{-1, 0, 1, 2, -1, -4}
+	got := KSum(nums, 0, 3)
+	assertValidTuples(t, nums, 0, 3, got)
+
+	seen := make(map[[3]int]bool)
+	for _, tuple := range got {
+		values := [3]int{nums[tuple[0]], nums[tuple[1]], nums[tuple[2]]}
+		sort.Ints(values[:])
+		if seen[values] {
+			t.Errorf("duplicate value-tuple %v returned", values)
+		}
+		seen[values] = true
+	}
+	if len(seen) != 2 {
+		t.Fatalf("got %d unique triplets, want 2: %v", len(seen), got)
+	}
+}
+
+func TestKSum4SumAllStrategiesAgree(t *testing.T) {
+	nums := []int{1, 0, -1, 0, -2, 2}
+	target := 0
+	k := 4
+
+	counts := make(map[Strategy]int)
+	for _, strategy := range []Strategy{StrategySortedRecursive, StrategyTwoPointer, StrategyHashMap} {
+		got := KSumWithStrategy(nums, target, k, strategy)
+		assertValidTuples(t, nums, target, k, got)
+		counts[strategy] = len(got)
+	}
+	for strategy, count := range counts {
+		if count != counts[StrategySortedRecursive] {
+			t.Errorf("strategy %v found %d tuples, want %d (matching StrategySortedRecursive)", strategy, count, counts[StrategySortedRecursive])
+		}
+	}
+}
+
+func TestKSumKGreaterThanLen(t *testing.T) {
+	if got := KSum([]int{1, 2}, 0, 3); got != nil {
+		t.Errorf("KSum() = %v, want nil when k > len(nums)", got)
+	}
+}